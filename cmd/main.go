@@ -20,7 +20,7 @@ func main() {
 	//logrus.SetLevel(logrus.ErrorLevel)
 	//logrus.Error("log error")
 
-	z := &zl.Config{
+	z := zl.Config{
 		JSON:            false,
 		Level:           "debug",
 		ComponentLevels: map[string]string{},
@@ -0,0 +1,48 @@
+package logger
+
+import "go.uber.org/zap/zapcore"
+
+// CheckedMessage bundles a logger and a message so callers can probe whether a
+// level is enabled before paying the cost of building keysAndValues, then log
+// without repeating the message. Useful when WithComponent loggers have distinct
+// atomic levels that would otherwise only be probed indirectly.
+//
+//	if cm := logger.Check(msg); cm.Enabled(zapcore.DebugLevel) {
+//		cm.Debugw(expensiveFields()...)
+//	}
+type CheckedMessage struct {
+	l   *ZapLogger
+	msg string
+}
+
+// Check returns a CheckedMessage for msg against this logger.
+func (l *ZapLogger) Check(msg string) *CheckedMessage {
+	return &CheckedMessage{l: l, msg: msg}
+}
+
+// Enabled reports whether level is enabled on the underlying logger.
+func (c *CheckedMessage) Enabled(level zapcore.Level) bool {
+	return c.l.Enabled(level)
+}
+
+func (c *CheckedMessage) Debugw(keysAndValues ...interface{}) {
+	c.l.zap.Debugw(c.msg, keysAndValues...)
+}
+
+func (c *CheckedMessage) Infow(keysAndValues ...interface{}) {
+	c.l.zap.Infow(c.msg, keysAndValues...)
+}
+
+func (c *CheckedMessage) Warnw(err error, keysAndValues ...interface{}) {
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err)
+	}
+	c.l.zap.Warnw(c.msg, keysAndValues...)
+}
+
+func (c *CheckedMessage) Errorw(err error, keysAndValues ...interface{}) {
+	if err != nil {
+		keysAndValues = append(keysAndValues, "error", err)
+	}
+	c.l.zap.Errorw(c.msg, keysAndValues...)
+}
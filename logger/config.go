@@ -0,0 +1,47 @@
+package logger
+
+// Config controls how NewZapLogger builds its underlying zap logger, including
+// encoding, sampling, per-component levels, and where log output is written. It
+// can also be loaded from YAML or TOML via WatchConfigFile, hence the struct tags.
+type Config struct {
+	// JSON selects JSON encoding for the default stderr output; console encoding is
+	// used otherwise. OutputPaths/ErrorOutputPaths entries are always JSON-encoded.
+	JSON bool `yaml:"json" toml:"json"`
+	// Level is the default zap level, parsed with ParseZapLevel (e.g. "debug", "info").
+	Level string `yaml:"level" toml:"level"`
+	// ComponentLevels overrides Level for specific components, keyed by the dotted
+	// component path produced by WithComponent (e.g. "rtc.signal").
+	ComponentLevels map[string]string `yaml:"component_levels" toml:"component_levels"`
+
+	Sample             bool `yaml:"sample" toml:"sample"`
+	ItemSampleSeconds  int  `yaml:"item_sample_seconds" toml:"item_sample_seconds"`
+	ItemSampleInitial  int  `yaml:"item_sample_initial" toml:"item_sample_initial"`
+	ItemSampleInterval int  `yaml:"item_sample_interval" toml:"item_sample_interval"`
+	SampleInterval     int  `yaml:"sample_interval" toml:"sample_interval"`
+
+	// OutputPaths are additional JSON-encoded sinks beyond the default stderr output,
+	// e.g. a rotated log file. Each entry gets its own zapcore.Core.
+	OutputPaths []string `yaml:"output_paths" toml:"output_paths"`
+	// ErrorOutputPaths mirrors OutputPaths but only receives Error level and above.
+	ErrorOutputPaths []string `yaml:"error_output_paths" toml:"error_output_paths"`
+	// Rotation configures log rotation for file entries in OutputPaths/ErrorOutputPaths.
+	// A zero value does not disable rotation: lumberjack still rolls at its own
+	// default thresholds (100MB max size, no age/backup limit).
+	Rotation Rotation `yaml:"rotation" toml:"rotation"`
+}
+
+// Rotation configures lumberjack-backed rotation for file sinks.
+type Rotation struct {
+	// MaxSize is the maximum size in megabytes of the log file before it gets rotated.
+	MaxSize int `yaml:"max_size" toml:"max_size"`
+	// MaxAge is the maximum number of days to retain old log files, based on the
+	// timestamp encoded in their filename.
+	MaxAge int `yaml:"max_age" toml:"max_age"`
+	// MaxBackups is the maximum number of old log files to retain.
+	MaxBackups int `yaml:"max_backups" toml:"max_backups"`
+	// Compress determines whether rotated log files should be compressed with gzip.
+	Compress bool `yaml:"compress" toml:"compress"`
+	// LocalTime determines whether rotated file timestamps use the host's local time
+	// instead of UTC.
+	LocalTime bool `yaml:"local_time" toml:"local_time"`
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type loggerCtxKey struct{}
+
+// NewContext returns a copy of ctx carrying l, retrievable with FromContext. This
+// generalizes the LoggerWithParticipant pattern into a first-class per-request
+// scoping mechanism: attach request-scoped fields once (see WithRequestID,
+// WithTraceID, WithSpanID) and thread the resulting Logger through ctx instead of
+// as an explicit parameter.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// FromContext returns the Logger attached to ctx by NewContext, or the package
+// default logger if none was attached.
+func FromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(loggerCtxKey{}).(Logger); ok {
+		return l
+	}
+	return GetLogger()
+}
+
+// WithRequestID returns a Logger that annotates every entry with request_id.
+func WithRequestID(l Logger, requestID string) Logger {
+	if requestID == "" {
+		return l
+	}
+	return l.WithValues("request_id", requestID)
+}
+
+// WithTraceID returns a Logger that annotates every entry with the trace_id of the
+// OpenTelemetry span found in ctx, if any.
+func WithTraceID(ctx context.Context, l Logger) Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return l
+	}
+	return l.WithValues("trace_id", sc.TraceID().String())
+}
+
+// WithSpanID returns a Logger that annotates every entry with the span_id of the
+// OpenTelemetry span found in ctx, if any.
+func WithSpanID(ctx context.Context, l Logger) Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasSpanID() {
+		return l
+	}
+	return l.WithValues("span_id", sc.SpanID().String())
+}
+
+// DebugwCtx logs at debug level using the Logger attached to ctx, falling back to
+// the package default logger if none was attached.
+func DebugwCtx(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	FromContext(ctx).Debugw(msg, keysAndValues...)
+}
+
+// InfowCtx logs at info level using the Logger attached to ctx, falling back to
+// the package default logger if none was attached.
+func InfowCtx(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	FromContext(ctx).Infow(msg, keysAndValues...)
+}
+
+// WarnwCtx logs at warn level using the Logger attached to ctx, falling back to
+// the package default logger if none was attached.
+func WarnwCtx(ctx context.Context, msg string, err error, keysAndValues ...interface{}) {
+	FromContext(ctx).Warnw(msg, err, keysAndValues...)
+}
+
+// ErrorwCtx logs at error level using the Logger attached to ctx, falling back to
+// the package default logger if none was attached.
+func ErrorwCtx(ctx context.Context, msg string, err error, keysAndValues ...interface{}) {
+	FromContext(ctx).Errorw(msg, err, keysAndValues...)
+}
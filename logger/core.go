@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"os"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// lockedMultiCore fans out log entries to a dynamic set of zapcore.Cores. Unlike
+// zapcore.NewTee, which freezes its core list at construction time, cores can be
+// added after the fact via addCore, guarded by mu so callers such as ZapLogger.AddCore
+// can attach sinks (e.g. an in-memory ring buffer for debug endpoints) without
+// recreating the logger.
+//
+// Cores built from Config (configCores) and cores attached later via addCore
+// (extraCores) are tracked separately so a config reload's swap only replaces the
+// former: extra sinks attached with AddCore must survive reload for the lifetime
+// of the process.
+type lockedMultiCore struct {
+	mu          sync.RWMutex
+	configCores []zapcore.Core
+	extraCores  []zapcore.Core
+}
+
+func newLockedMultiCore(cores ...zapcore.Core) *lockedMultiCore {
+	return &lockedMultiCore{configCores: cores}
+}
+
+func (c *lockedMultiCore) addCore(core zapcore.Core) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.extraCores = append(c.extraCores, core)
+}
+
+// swap replaces the config-derived core set, e.g. when a config reload changes
+// output sinks, leaving any cores attached via addCore untouched.
+func (c *lockedMultiCore) swap(cores []zapcore.Core) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configCores = cores
+}
+
+func (c *lockedMultiCore) snapshot() []zapcore.Core {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cores := make([]zapcore.Core, 0, len(c.configCores)+len(c.extraCores))
+	cores = append(cores, c.configCores...)
+	cores = append(cores, c.extraCores...)
+	return cores
+}
+
+func (c *lockedMultiCore) Enabled(level zapcore.Level) bool {
+	for _, core := range c.snapshot() {
+		if core.Enabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *lockedMultiCore) With(fields []zapcore.Field) zapcore.Core {
+	cores := c.snapshot()
+	with := make([]zapcore.Core, len(cores))
+	for i, core := range cores {
+		with[i] = core.With(fields)
+	}
+	return newLockedMultiCore(with...)
+}
+
+func (c *lockedMultiCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	for _, core := range c.snapshot() {
+		checked = core.Check(entry, checked)
+	}
+	return checked
+}
+
+func (c *lockedMultiCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	var err error
+	for _, core := range c.snapshot() {
+		if writeErr := core.Write(entry, fields); writeErr != nil {
+			err = writeErr
+		}
+	}
+	return err
+}
+
+func (c *lockedMultiCore) Sync() error {
+	var err error
+	for _, core := range c.snapshot() {
+		if syncErr := core.Sync(); syncErr != nil {
+			err = syncErr
+		}
+	}
+	return err
+}
+
+// rotationWriteSyncer wraps a lumberjack logger rotated per conf.Rotation. Note
+// that a zero Rotation does NOT disable rotation: lumberjack defaults MaxSize to
+// 100MB when unset, so the file still rolls, just at lumberjack's own default
+// thresholds rather than the caller's.
+func rotationWriteSyncer(path string, rotation Rotation) zapcore.WriteSyncer {
+	return zapcore.AddSync(&lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    rotation.MaxSize,
+		MaxAge:     rotation.MaxAge,
+		MaxBackups: rotation.MaxBackups,
+		Compress:   rotation.Compress,
+		LocalTime:  rotation.LocalTime,
+	})
+}
+
+// stdoutSyncer and stderrSyncer are shared, process-wide write syncers for the
+// conventional zap sink names "stdout"/"stderr". Every core that writes to one of
+// these streams must share the same zapcore.Lock mutex instance, or two cores
+// each locking their own wrapper around the same fd (e.g. the default console
+// sink plus an ErrorOutputPaths entry of "stderr") can still interleave writes
+// mid-line.
+var (
+	stdoutSyncer = zapcore.Lock(zapcore.AddSync(os.Stdout))
+	stderrSyncer = zapcore.Lock(zapcore.AddSync(os.Stderr))
+)
+
+// fileWriteSyncer resolves the conventional zap sink names "stdout"/"stderr" to
+// the shared stdoutSyncer/stderrSyncer, matching the convention used by zap's
+// own default OutputPaths, and otherwise opens path as a rotated file
+// via rotationWriteSyncer.
+func fileWriteSyncer(path string, rotation Rotation) zapcore.WriteSyncer {
+	switch path {
+	case "stdout":
+		return stdoutSyncer
+	case "stderr":
+		return stderrSyncer
+	default:
+		return rotationWriteSyncer(path, rotation)
+	}
+}
+
+// buildCores constructs one zapcore.Core per configured sink: a core on stderr
+// (JSON-encoded if conf.JSON is set, console-encoded otherwise) plus a JSON core
+// per entry in conf.OutputPaths/ErrorOutputPaths. File entries are rotated
+// according to conf.Rotation via lumberjack; "stdout"/"stderr" entries write to
+// the shared std stream syncers and are not rotated.
+func buildCores(conf *Config, level zapcore.LevelEnabler) ([]zapcore.Core, error) {
+	consoleEncoder := zap.NewDevelopmentEncoderConfig()
+	jsonEncoder := zap.NewProductionEncoderConfig()
+	jsonEncoder.EncodeLevel = zapcore.CapitalColorLevelEncoder
+
+	var encCfg zapcore.EncoderConfig
+	var encoding string
+	if conf.JSON {
+		encCfg, encoding = jsonEncoder, "json"
+	} else {
+		encCfg, encoding = consoleEncoder, "console"
+	}
+	encoder, err := newEncoder(encoding, encCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cores := []zapcore.Core{
+		zapcore.NewCore(encoder, stderrSyncer, level),
+	}
+
+	for _, path := range conf.OutputPaths {
+		fileEncoder, err := newEncoder("json", jsonEncoder)
+		if err != nil {
+			return nil, err
+		}
+		cores = append(cores, zapcore.NewCore(fileEncoder, fileWriteSyncer(path, conf.Rotation), level))
+	}
+
+	for _, path := range conf.ErrorOutputPaths {
+		fileEncoder, err := newEncoder("json", jsonEncoder)
+		if err != nil {
+			return nil, err
+		}
+		errorLevel := zapcore.LevelEnabler(zap.NewAtomicLevelAt(zapcore.ErrorLevel))
+		cores = append(cores, zapcore.NewCore(fileEncoder, fileWriteSyncer(path, conf.Rotation), errorLevel))
+	}
+
+	return cores, nil
+}
+
+func newEncoder(encoding string, cfg zapcore.EncoderConfig) (zapcore.Encoder, error) {
+	switch encoding {
+	case "json":
+		return zapcore.NewJSONEncoder(cfg), nil
+	default:
+		return zapcore.NewConsoleEncoder(cfg), nil
+	}
+}
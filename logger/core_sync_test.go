@@ -0,0 +1,12 @@
+package logger
+
+import "testing"
+
+func TestFileWriteSyncerSharesStdStreamLock(t *testing.T) {
+	if fileWriteSyncer("stderr", Rotation{}) != stderrSyncer {
+		t.Fatal("fileWriteSyncer(\"stderr\", ...) must return the shared stderrSyncer, not a fresh wrapper around os.Stderr")
+	}
+	if fileWriteSyncer("stdout", Rotation{}) != stdoutSyncer {
+		t.Fatal("fileWriteSyncer(\"stdout\", ...) must return the shared stdoutSyncer, not a fresh wrapper around os.Stdout")
+	}
+}
@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// countingCore is a minimal zapcore.Core that counts Write calls, used to assert
+// which cores received a given log entry.
+type countingCore struct {
+	writes int
+}
+
+func (c *countingCore) Enabled(zapcore.Level) bool                       { return true }
+func (c *countingCore) With([]zapcore.Field) zapcore.Core                { return c }
+func (c *countingCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(e, c)
+}
+func (c *countingCore) Write(zapcore.Entry, []zapcore.Field) error { c.writes++; return nil }
+func (c *countingCore) Sync() error                                { return nil }
+
+func TestLockedMultiCoreSwapPreservesAddedCores(t *testing.T) {
+	configCore := &countingCore{}
+	extraCore := &countingCore{}
+
+	mc := newLockedMultiCore(configCore)
+	mc.addCore(extraCore)
+
+	entry := zapcore.Entry{Level: zapcore.InfoLevel}
+	write := func() {
+		if ce := mc.Check(entry, nil); ce != nil {
+			ce.Write()
+		}
+	}
+
+	write()
+	if configCore.writes != 1 || extraCore.writes != 1 {
+		t.Fatalf("before swap: configCore.writes=%d extraCore.writes=%d, want 1 and 1", configCore.writes, extraCore.writes)
+	}
+
+	// Simulate a config reload swapping in a new set of config-derived cores.
+	reloadedCore := &countingCore{}
+	mc.swap([]zapcore.Core{reloadedCore})
+
+	write()
+	if reloadedCore.writes != 1 {
+		t.Fatalf("after swap: reloadedCore.writes=%d, want 1", reloadedCore.writes)
+	}
+	if extraCore.writes != 2 {
+		t.Fatalf("after swap: extraCore.writes=%d, want 2 (AddCore-attached sink must survive reload)", extraCore.writes)
+	}
+	if configCore.writes != 1 {
+		t.Fatalf("after swap: configCore.writes=%d, want 1 (stale config core must stop receiving entries)", configCore.writes)
+	}
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Structured, zap.Field-based API, parallel to the sugared Logger interface above.
+// It's backed by the non-sugared *zap.Logger, so call sites that already hold
+// zap.Field values avoid the reflection and allocation of the keysAndValues path.
+
+func (l *ZapLogger) Debug(msg string, fields ...zap.Field) {
+	if !l.Enabled(zapcore.DebugLevel) {
+		return
+	}
+	l.structured.Debug(msg, fields...)
+}
+
+func (l *ZapLogger) Info(msg string, fields ...zap.Field) {
+	if !l.Enabled(zapcore.InfoLevel) {
+		return
+	}
+	l.structured.Info(msg, fields...)
+}
+
+func (l *ZapLogger) Warn(msg string, fields ...zap.Field) {
+	if !l.Enabled(zapcore.WarnLevel) {
+		return
+	}
+	l.structured.Warn(msg, fields...)
+}
+
+func (l *ZapLogger) Error(msg string, fields ...zap.Field) {
+	if !l.Enabled(zapcore.ErrorLevel) {
+		return
+	}
+	l.structured.Error(msg, fields...)
+}
+
+// Typed field constructors, re-exported so callers don't need to import
+// go.uber.org/zap directly just to build a field for Debug/Info/Warn/Error above.
+
+func String(key, val string) zap.Field {
+	return zap.String(key, val)
+}
+
+func Int64(key string, val int64) zap.Field {
+	return zap.Int64(key, val)
+}
+
+func Duration(key string, val time.Duration) zap.Field {
+	return zap.Duration(key, val)
+}
+
+func Stringer(key string, val fmt.Stringer) zap.Field {
+	return zap.Stringer(key, val)
+}
+
+func Any(key string, val interface{}) zap.Field {
+	return zap.Any(key, val)
+}
+
+func Err(err error) zap.Field {
+	return zap.Error(err)
+}
@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"fmt"
+	"log"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/grpclog"
+)
+
+// grpcLogger adapts a ZapLogger to grpclog.LoggerV2.
+type grpcLogger struct {
+	l *ZapLogger
+	s *zap.SugaredLogger
+	// skipped is l with the caller skip needed for Warning/Warningf to report the
+	// same call site as Info/Error do through s: one more than s's own +2, since
+	// going through the Logger.Warnw interface method adds an extra indirection
+	// frame (ZapLogger.Warnw itself) that calling s.Warn directly would not.
+	skipped Logger
+}
+
+// GRPC returns a grpclog.LoggerV2 backed by this logger, suitable for
+// grpclog.SetLoggerV2. An extra caller skip accounts for the grpclog indirection so
+// caller info in log lines still points at the real call site inside grpc-go.
+func (l *ZapLogger) GRPC() grpclog.LoggerV2 {
+	return &grpcLogger{
+		l:       l,
+		s:       l.zap.WithOptions(zap.AddCallerSkip(2)),
+		skipped: l.WithCallDepth(3),
+	}
+}
+
+func (g *grpcLogger) Info(args ...interface{})                { g.s.Info(args...) }
+func (g *grpcLogger) Infoln(args ...interface{})               { g.s.Info(args...) }
+func (g *grpcLogger) Infof(format string, args ...interface{}) { g.s.Infof(format, args...) }
+
+// Warning/Warningf route through Warnw (with a nil error, so they pick up the
+// same "error" field semantics as every other Warnw call site) on skipped rather
+// than s, so they report the same call site as every other severity here.
+func (g *grpcLogger) Warning(args ...interface{}) {
+	g.skipped.Warnw(fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Warningln(args ...interface{}) {
+	g.skipped.Warnw(fmt.Sprint(args...), nil)
+}
+
+func (g *grpcLogger) Warningf(format string, args ...interface{}) {
+	g.skipped.Warnw(fmt.Sprintf(format, args...), nil)
+}
+
+func (g *grpcLogger) Error(args ...interface{})                { g.s.Error(args...) }
+func (g *grpcLogger) Errorln(args ...interface{})               { g.s.Error(args...) }
+func (g *grpcLogger) Errorf(format string, args ...interface{}) { g.s.Errorf(format, args...) }
+
+func (g *grpcLogger) Fatal(args ...interface{})                { g.s.Fatal(args...) }
+func (g *grpcLogger) Fatalln(args ...interface{})               { g.s.Fatal(args...) }
+func (g *grpcLogger) Fatalf(format string, args ...interface{}) { g.s.Fatalf(format, args...) }
+
+// Println logs args at info level, like Infoln. Print and Printf are not provided,
+// so the adapter does not satisfy the legacy grpclog.Logger interface.
+func (g *grpcLogger) Println(args ...interface{}) { g.s.Info(args...) }
+
+// V reports whether verbosity level is enabled: level 0 reports whether info is
+// enabled on this logger, and any level above 0 whether debug is enabled.
+func (g *grpcLogger) V(level int) bool {
+	if level > 0 {
+		return g.l.Enabled(zapcore.DebugLevel)
+	}
+	return g.l.Enabled(zapcore.InfoLevel)
+}
+
+// StdLogger returns a standard library *log.Logger whose output is routed through
+// this logger at the given level, via zap.NewStdLogAt.
+func (l *ZapLogger) StdLogger(level zapcore.Level) *log.Logger {
+	std, err := zap.NewStdLogAt(l.zap.Desugar(), level)
+	if err != nil {
+		// Desugar/NewStdLogAt only fail if level is invalid, which can't happen
+		// with a zapcore.Level constant; fall back rather than panic.
+		return log.Default()
+	}
+	return std
+}
+
+// RedirectStdLog redirects output from the standard library's default logger (as
+// used by e.g. the log package's top-level Print family) to this logger at info
+// level, returning a function to restore the prior behavior.
+func (l *ZapLogger) RedirectStdLog() func() {
+	return zap.RedirectStdLog(l.zap.Desugar())
+}
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// callerCapturingCore records the caller reported on each entry it receives, so
+// tests can assert grpcLogger's Warning* methods report the same call site depth
+// as Info/Error instead of adding an extra ZapLogger.Warnw indirection frame.
+type callerCapturingCore struct {
+	callers []string
+}
+
+func (c *callerCapturingCore) Enabled(zapcore.Level) bool        { return true }
+func (c *callerCapturingCore) With([]zapcore.Field) zapcore.Core { return c }
+func (c *callerCapturingCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(e, c)
+}
+func (c *callerCapturingCore) Write(e zapcore.Entry, _ []zapcore.Field) error {
+	c.callers = append(c.callers, e.Caller.String())
+	return nil
+}
+func (c *callerCapturingCore) Sync() error { return nil }
+
+func logThroughGRPCAdapter(g interface {
+	Info(args ...interface{})
+	Warning(args ...interface{})
+}) {
+	g.Info("info")
+	g.Warning("warning")
+}
+
+func TestGRPCLoggerWarningReportsSameCallerDepthAsInfo(t *testing.T) {
+	capture := &callerCapturingCore{}
+	l, err := NewZapLogger(&Config{Level: "debug"})
+	if err != nil {
+		t.Fatalf("NewZapLogger: %v", err)
+	}
+	l.cores.swap([]zapcore.Core{capture})
+
+	logThroughGRPCAdapter(l.GRPC())
+
+	if len(capture.callers) != 2 {
+		t.Fatalf("got %d entries, want 2", len(capture.callers))
+	}
+	if capture.callers[0] != capture.callers[1] {
+		t.Fatalf("Info caller = %q, Warning caller = %q; Warning must report the same call site depth as Info", capture.callers[0], capture.callers[1])
+	}
+}
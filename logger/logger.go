@@ -92,10 +92,13 @@ type ZapLogger struct {
 	zap *zap.SugaredLogger
 	// store original logger without sampling to avoid multiple samplers
 	unsampled *zap.SugaredLogger
-	component string
+	// structured mirrors zap but exposes the non-sugared, zap.Field-based API
+	structured *zap.Logger
+	component  string
 	// use a nested field as pointer so that all loggers share the same sharedConfig
 	sharedConfig   *sharedConfig
 	level          zap.AtomicLevel
+	cores          *lockedMultiCore
 	SampleDuration time.Duration
 	SampleInitial  int
 	SampleInterval int
@@ -110,23 +113,13 @@ func NewZapLogger(conf *Config) (*ZapLogger, error) {
 		SampleInitial:  conf.ItemSampleInitial,
 		SampleInterval: conf.ItemSampleInterval,
 	}
-	zc := zap.Config{
-		Level:            zap.NewAtomicLevelAt(zapcore.DebugLevel),
-		Development:      false,
-		Encoding:         "console",
-		EncoderConfig:    zap.NewDevelopmentEncoderConfig(),
-		OutputPaths:      []string{"stderr"},
-		ErrorOutputPaths: []string{"stderr"},
-	}
-	if conf.JSON {
-		zc.Encoding = "json"
-		zc.EncoderConfig = zap.NewProductionEncoderConfig()
-		zc.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
-	}
-	build, err := zc.Build()
+
+	cores, err := buildCores(conf, zapcore.DebugLevel)
 	if err != nil {
 		return nil, err
 	}
+	zaplog.cores = newLockedMultiCore(cores...)
+	build := zap.New(zaplog.cores, zap.AddCaller())
 	zaplog.unsampled = build.Sugar()
 
 	if conf.Sample {
@@ -154,14 +147,27 @@ func NewZapLogger(conf *Config) (*ZapLogger, error) {
 	} else {
 		zaplog.zap = zaplog.unsampled
 	}
+	zaplog.structured = zaplog.zap.Desugar()
 	return zaplog, nil
 }
 
+// AddCore attaches an additional zapcore.Core to this logger, such as an in-memory
+// ring buffer for debug endpoints, without recreating the logger. Loggers derived
+// from it via WithName/WithComponent/WithCallDepth share the same underlying cores
+// and see the added core too. Loggers derived via WithValues hold a copy of the
+// core set taken when WithValues was called, so they do not see cores added (or
+// swapped in by a config reload) afterwards.
+func (l *ZapLogger) AddCore(core zapcore.Core) {
+	l.cores.addCore(core)
+}
+
 type sharedConfig struct {
 	level           zap.AtomicLevel
 	lc              sync.Mutex
 	componentLevels map[string]zap.AtomicLevel
 	config          *Config
+	// watchPath is the config file last passed to WatchConfigFile, re-read by ReloadOn.
+	watchPath string
 }
 
 func newSharedConfig(conf *Config) *sharedConfig {
@@ -215,26 +221,37 @@ func (l *ZapLogger) ToZap() *zap.SugaredLogger {
 
 type LogRLogger logr.Logger
 
-func (l *ZapLogger) isEnabled(level zapcore.Level) bool {
+// Enabled reports whether level is enabled for this logger's effective component
+// level, satisfying zapcore.LevelEnabler so external code can use zapcore.LevelOf
+// to discover it.
+func (l *ZapLogger) Enabled(level zapcore.Level) bool {
 	return level >= l.level.Level()
 }
 
+// Level returns the effective level for this logger's component, i.e. the level
+// set via WithComponent/sharedConfig.setEffectiveLevel, or the shared default.
+func (l *ZapLogger) Level() zapcore.Level {
+	return l.level.Level()
+}
+
+var _ zapcore.LevelEnabler = (*ZapLogger)(nil)
+
 func (l *ZapLogger) Debugw(msg string, keysAndValues ...interface{}) {
-	if !l.isEnabled(zapcore.DebugLevel) {
+	if !l.Enabled(zapcore.DebugLevel) {
 		return
 	}
 	l.zap.Debugw(msg, keysAndValues...)
 }
 
 func (l *ZapLogger) Infow(msg string, keysAndValues ...interface{}) {
-	if !l.isEnabled(zapcore.InfoLevel) {
+	if !l.Enabled(zapcore.InfoLevel) {
 		return
 	}
 	l.zap.Infow(msg, keysAndValues...)
 }
 
 func (l *ZapLogger) Warnw(msg string, err error, keysAndValues ...interface{}) {
-	if !l.isEnabled(zapcore.WarnLevel) {
+	if !l.Enabled(zapcore.WarnLevel) {
 		return
 	}
 	if err != nil {
@@ -244,7 +261,7 @@ func (l *ZapLogger) Warnw(msg string, err error, keysAndValues ...interface{}) {
 }
 
 func (l *ZapLogger) Errorw(msg string, err error, keysAndValues ...interface{}) {
-	if !l.isEnabled(zapcore.ErrorLevel) {
+	if !l.Enabled(zapcore.ErrorLevel) {
 		return
 	}
 	if err != nil {
@@ -262,6 +279,7 @@ func (l *ZapLogger) WithValues(keysAndValues ...interface{}) Logger {
 	} else {
 		dup.unsampled = l.unsampled.With(keysAndValues...)
 	}
+	dup.structured = dup.zap.Desugar()
 	return &dup
 }
 
@@ -273,6 +291,7 @@ func (l *ZapLogger) WithName(name string) Logger {
 	} else {
 		dup.unsampled = l.unsampled.Named(name)
 	}
+	dup.structured = dup.zap.Desugar()
 	return &dup
 }
 
@@ -296,6 +315,7 @@ func (l *ZapLogger) WithCallDepth(depth int) Logger {
 	} else {
 		dup.unsampled = l.unsampled.WithOptions(zap.AddCallerSkip(depth))
 	}
+	dup.structured = dup.zap.Desugar()
 	return &dup
 }
 
@@ -312,6 +332,7 @@ func (l *ZapLogger) WithItemSampler() Logger {
 			l.SampleInterval,
 		)
 	}))
+	dup.structured = dup.zap.Desugar()
 	return &dup
 }
 
@@ -321,6 +342,7 @@ func (l *ZapLogger) WithoutSampler() Logger {
 	}
 	dup := *l
 	dup.zap = l.unsampled
+	dup.structured = dup.zap.Desugar()
 	return &dup
 }
 
@@ -348,6 +370,16 @@ func (cfg *sharedConfig) onConfigUpdate(conf *Config) error {
 	return nil
 }
 
+// componentLevel looks up an already-registered component's AtomicLevel without
+// creating one, unlike setEffectiveLevel. Used by ComponentLevelHandler, which is
+// reachable over HTTP and must not let arbitrary query values grow componentLevels.
+func (c *sharedConfig) componentLevel(component string) (zap.AtomicLevel, bool) {
+	c.lc.Lock()
+	defer c.lc.Unlock()
+	lvl, ok := c.componentLevels[component]
+	return lvl, ok
+}
+
 // 动态更新日志等级，后续使用
 func (c *sharedConfig) setEffectiveLevel(component string) zap.AtomicLevel {
 	c.lc.Lock()
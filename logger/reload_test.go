@@ -0,0 +1,61 @@
+package logger
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddCoreSurvivesConfigReload(t *testing.T) {
+	l, err := NewZapLogger(&Config{Level: "debug"})
+	if err != nil {
+		t.Fatalf("NewZapLogger: %v", err)
+	}
+
+	extra := &countingCore{}
+	l.AddCore(extra)
+
+	l.Infow("before reload")
+	if extra.writes != 1 {
+		t.Fatalf("extra.writes=%d before reload, want 1", extra.writes)
+	}
+
+	if err := l.swapCores(&Config{Level: "debug"}); err != nil {
+		t.Fatalf("swapCores: %v", err)
+	}
+
+	l.Infow("after reload")
+	if extra.writes != 2 {
+		t.Fatalf("extra.writes=%d after reload, want 2 (AddCore sink must survive reload)", extra.writes)
+	}
+}
+
+func TestComponentLevelHandlerUnknownComponent(t *testing.T) {
+	l, err := NewZapLogger(&Config{Level: "info"})
+	if err != nil {
+		t.Fatalf("NewZapLogger: %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/level?component=does.not.exist", nil)
+	rec := httptest.NewRecorder()
+	l.ComponentLevelHandler().ServeHTTP(rec, req)
+
+	if rec.Code != 404 {
+		t.Fatalf("status = %d, want 404 for unregistered component", rec.Code)
+	}
+}
+
+func TestComponentLevelHandlerKnownComponent(t *testing.T) {
+	l, err := NewZapLogger(&Config{Level: "info"})
+	if err != nil {
+		t.Fatalf("NewZapLogger: %v", err)
+	}
+	_ = l.WithComponent("rtc").(*ZapLogger)
+
+	req := httptest.NewRequest("GET", "/level?component=rtc", nil)
+	rec := httptest.NewRecorder()
+	l.ComponentLevelHandler().ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("status = %d, want 200 for registered component", rec.Code)
+	}
+}